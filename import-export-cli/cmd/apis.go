@@ -25,6 +25,9 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/wso2/product-apim-tooling/import-export-cli/credentials"
@@ -32,6 +35,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/wso2/product-apim-tooling/import-export-cli/formatter"
 	"github.com/wso2/product-apim-tooling/import-export-cli/utils"
+	"github.com/wso2/product-apim-tooling/import-export-cli/utils/sortorder"
 )
 
 const (
@@ -43,11 +47,30 @@ const (
 	apiStatusHeader   = "STATUS"
 
 	defaultApiTableFormat = "table {{.Id}}\t{{.Name}}\t{{.Version}}\t{{.Context}}\t{{.Status}}\t{{.Provider}}"
+
+	// apiListFetchPageSize is the page size used for the internal paginated
+	// calls that fetch the full API list from the publisher before it is
+	// sorted; it is independent of the --limit/--offset flags, which only
+	// control how much of the already-fetched, already-sorted list is shown.
+	apiListFetchPageSize = 25
 )
 
 var listApisCmdEnvironment string
 var listApisCmdFormat string
 var listApisCmdQuery string
+var listApisCmdSortBy string
+var listApisCmdLimit int
+var listApisCmdOffset int
+
+// apiSortColumns maps a --sort-by column name to the accessor used to read
+// that column off an utils.API for comparison.
+var apiSortColumns = map[string]func(utils.API) string{
+	"name":     func(a utils.API) string { return a.Name },
+	"version":  func(a utils.API) string { return a.Version },
+	"context":  func(a utils.API) string { return a.Context },
+	"provider": func(a utils.API) string { return a.Provider },
+	"status":   func(a utils.API) string { return a.Status },
+}
 
 // apisCmd related info
 const apisCmdLiteral = "apis"
@@ -58,7 +81,9 @@ const apisCmdLongDesc = `Display a list of APIs in the environment specified by
 var apisCmdExamples = utils.ProjectName + ` ` + apisCmdLiteral + ` ` + listCmdLiteral + ` -e dev
 ` + utils.ProjectName + ` ` + apisCmdLiteral + ` ` + listCmdLiteral + ` -e dev -q version:1.0.0
 ` + utils.ProjectName + ` ` + apisCmdLiteral + ` ` + listCmdLiteral + ` -e prod -q provider:admin
-` + utils.ProjectName + ` ` + apisCmdLiteral + ` ` + listCmdLiteral + ` -e staging`
+` + utils.ProjectName + ` ` + apisCmdLiteral + ` ` + listCmdLiteral + ` -e staging
+` + utils.ProjectName + ` ` + apisCmdLiteral + ` ` + listCmdLiteral + ` -e dev --sort-by version
+` + utils.ProjectName + ` ` + apisCmdLiteral + ` ` + listCmdLiteral + ` -e dev --sort-by -name --limit 10 --offset 20`
 
 // apisCmd represents the apis command
 var apisCmd = &cobra.Command{
@@ -136,13 +161,20 @@ func executeApisCmd(credential credentials.Credential) {
 	apiListEndpoint := utils.GetApiListEndpointOfEnv(listApisCmdEnvironment, utils.MainConfigFilePath)
 	_, apis, err := GetAPIList(listApisCmdQuery, accessToken, apiListEndpoint)
 	if err == nil {
+		sortAPIs(apis, listApisCmdSortBy)
+		apis = paginateAPIs(apis, listApisCmdLimit, listApisCmdOffset)
 		printAPIs(apis, listApisCmdFormat)
 	} else {
 		utils.Logln(utils.LogPrefixError+"Getting List of APIs", err)
 	}
 }
 
-// GetAPIList
+// GetAPIList fetches the full list of APIs matching query by issuing
+// repeated paginated REST calls against apiListEndpoint (using limit/offset
+// query params, apiListFetchPageSize at a time) until the server-reported
+// count is exhausted. The result is returned in whatever order the server
+// paginated it in; callers that need a specific order should sort it
+// themselves, e.g. with sortAPIs.
 // @param query : string to be matched against the API names
 // @param accessToken : Access Token for the environment
 // @param apiManagerEndpoint : API Manager Endpoint for the environment
@@ -153,31 +185,90 @@ func GetAPIList(query, accessToken, apiListEndpoint string) (count int32, apis [
 	headers := make(map[string]string)
 	headers[utils.HeaderAuthorization] = utils.HeaderValueAuthBearerPrefix + " " + accessToken
 
-	if query != "" {
-		apiListEndpoint += "?query=" + query
-	}
-	utils.Logln(utils.LogPrefixInfo+"URL:", apiListEndpoint)
-	resp, err := utils.InvokeGETRequest(apiListEndpoint, headers)
+	offset := 0
+	for {
+		pageEndpoint := apiListEndpoint + "?limit=" + strconv.Itoa(apiListFetchPageSize) +
+			"&offset=" + strconv.Itoa(offset)
+		if query != "" {
+			pageEndpoint += "&query=" + query
+		}
 
-	if err != nil {
-		utils.HandleErrorAndExit("Unable to connect to "+apiListEndpoint, err)
-	}
+		utils.Logln(utils.LogPrefixInfo+"URL:", pageEndpoint)
+		resp, err := utils.InvokeGETRequest(pageEndpoint, headers)
+		if err != nil {
+			utils.HandleErrorAndExit("Unable to connect to "+pageEndpoint, err)
+		}
 
-	utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
+		utils.Logln(utils.LogPrefixInfo+"Response:", resp.Status())
 
-	if resp.StatusCode() == http.StatusOK {
-		apiListResponse := &utils.APIListResponse{}
-		unmarshalError := json.Unmarshal([]byte(resp.Body()), &apiListResponse)
+		if resp.StatusCode() != http.StatusOK {
+			return 0, nil, errors.New(string(resp.Body()))
+		}
 
-		if unmarshalError != nil {
+		apiListResponse := &utils.APIListResponse{}
+		if unmarshalError := json.Unmarshal([]byte(resp.Body()), &apiListResponse); unmarshalError != nil {
 			utils.HandleErrorAndExit(utils.LogPrefixError+"invalid JSON response", unmarshalError)
 		}
 
-		return apiListResponse.Count, apiListResponse.List, nil
-	} else {
-		return 0, nil, errors.New(string(resp.Body()))
+		apis = append(apis, apiListResponse.List...)
+		count = apiListResponse.Count
+
+		offset += len(apiListResponse.List)
+		if len(apiListResponse.List) == 0 || int32(offset) >= count {
+			break
+		}
 	}
 
+	return count, apis, nil
+}
+
+// sortAPIs sorts apis in place according to sortBy, a column name from
+// apiSortColumns optionally prefixed with "-" for descending order (e.g.
+// "version" or "-name"). An empty or unrecognised sortBy leaves apis
+// untouched. The "version" column uses sortorder.NaturalLess so that
+// 1.2.0 < 1.10.0 < 2.0.0. The sort is stable so ties keep the order apis
+// were fetched in.
+func sortAPIs(apis []utils.API, sortBy string) {
+	descending := strings.HasPrefix(sortBy, "-")
+	column := strings.TrimPrefix(sortBy, "-")
+
+	accessor, ok := apiSortColumns[column]
+	if !ok {
+		return
+	}
+
+	less := func(i, j int) bool {
+		a, b := accessor(apis[i]), accessor(apis[j])
+		if column == "version" {
+			return sortorder.NaturalLess(a, b)
+		}
+		return a < b
+	}
+	if descending {
+		unordered := less
+		less = func(i, j int) bool { return unordered(j, i) }
+	}
+
+	sort.SliceStable(apis, less)
+}
+
+// paginateAPIs returns the slice of apis starting at offset and containing
+// at most limit elements. A non-positive limit means "no limit" (everything
+// from offset onwards); a negative offset is treated as 0, and an offset at
+// or past the end yields an empty slice.
+func paginateAPIs(apis []utils.API, limit, offset int) []utils.API {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(apis) {
+		return []utils.API{}
+	}
+	apis = apis[offset:]
+
+	if limit > 0 && limit < len(apis) {
+		apis = apis[:limit]
+	}
+	return apis
 }
 
 // printAPIs
@@ -224,5 +315,7 @@ func init() {
 		"", "Query pattern")
 	apisCmd.Flags().StringVarP(&listApisCmdFormat, "format", "", "", "Pretty-print apis "+
 		"using Go Templates. Use {{ jsonPretty . }} to list all fields")
+	registerSortAndPaginationFlags(apisCmd, &listApisCmdSortBy, &listApisCmdLimit, &listApisCmdOffset,
+		"name, version, context, provider or status")
 	_ = apisCmd.MarkFlagRequired("environment")
 }
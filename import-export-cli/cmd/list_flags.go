@@ -0,0 +1,33 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+// registerSortAndPaginationFlags adds the --sort-by, --limit and --offset
+// flags to cmd. The apis "list" subcommand is the only caller; the apps and
+// apiproducts list commands do not register these flags. columnsDesc should name the columns sortBy accepts, for the
+// --sort-by flag's usage text (e.g. "name, version, context, provider or
+// status").
+func registerSortAndPaginationFlags(cmd *cobra.Command, sortBy *string, limit, offset *int, columnsDesc string) {
+	cmd.Flags().StringVar(sortBy, "sort-by", "", "Sort results by "+columnsDesc+
+		"; prefix with - for descending order")
+	cmd.Flags().IntVar(limit, "limit", 0, "Maximum number of results to display; 0 means no limit")
+	cmd.Flags().IntVar(offset, "offset", 0, "Number of results to skip before displaying results")
+}
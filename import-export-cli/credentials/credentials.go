@@ -0,0 +1,73 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package credentials
+
+// Credential holds the username/password (or client secret, stored in the
+// Password field) used to obtain an OAuth access token for an environment.
+type Credential struct {
+	Username string
+	Password string
+}
+
+// Store resolves the credential helper configured for the CLI, identified by
+// helperName (the value of the credentials.store field in main_config.yaml).
+// An empty helperName means no helper is configured.
+func Store(helperName string) Helper {
+	if helperName == "" {
+		return nil
+	}
+	return NewHelper(helperName)
+}
+
+// FromHelperOrStore first consults the configured credential helper for env,
+// falling back to fileCred (typically loaded from the encrypted on-disk
+// store) if no helper is configured or the helper has no entry for env.
+// fromHelper reports whether the returned Credential came from the helper.
+func FromHelperOrStore(helperName, env string, fileCred Credential) (cred Credential, fromHelper bool, err error) {
+	helper := Store(helperName)
+	if helper == nil {
+		return fileCred, false, nil
+	}
+
+	username, secret, err := helper.Get(env)
+	if err != nil {
+		// Degrade gracefully: a missing or empty helper entry isn't fatal,
+		// it just means the file store is authoritative for this env.
+		return fileCred, false, nil
+	}
+	if username == "" && secret == "" {
+		return fileCred, false, nil
+	}
+
+	return Credential{Username: username, Password: secret}, true, nil
+}
+
+// ToHelperOrStore persists cred for env via the configured credential helper
+// when one is set; otherwise it is the caller's responsibility to persist
+// cred to the encrypted file store.
+func ToHelperOrStore(helperName, env string, cred Credential) (stored bool, err error) {
+	helper := Store(helperName)
+	if helper == nil {
+		return false, nil
+	}
+	if err := helper.Store(env, cred.Username, cred.Password); err != nil {
+		return false, err
+	}
+	return true, nil
+}
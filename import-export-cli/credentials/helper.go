@@ -0,0 +1,133 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package credentials
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+)
+
+// helperBinaryPrefix is prepended to the configured helper name to resolve the
+// executable, following the same convention used by the Docker credential helper
+// protocol (e.g. "osxkeychain" -> "docker-credential-osxkeychain").
+const helperBinaryPrefix = "docker-credential-"
+
+// Helper is implemented by credential backends that can store, retrieve and
+// erase the username/secret pair for a named environment on behalf of the CLI.
+type Helper interface {
+	// Get returns the username and secret stored for env.
+	Get(env string) (username string, secret string, err error)
+	// Store persists the username and secret for env.
+	Store(env, username, secret string) error
+	// Erase removes any credentials stored for env.
+	Erase(env string) error
+}
+
+// helperCredentials is the JSON payload exchanged with a credential helper
+// binary on stdin/stdout, matching the shape used by Docker credential helpers.
+type helperCredentials struct {
+	ServerURL string `json:"ServerURL"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+// helperExec is a Helper implementation that shells out to a
+// "docker-credential-<name>" executable found on $PATH.
+type helperExec struct {
+	name string
+}
+
+// NewHelper returns a Helper that delegates to the docker-credential-<name>
+// executable. The executable must be present on $PATH.
+func NewHelper(name string) Helper {
+	return &helperExec{name: name}
+}
+
+// Get invokes "docker-credential-<name> get" and returns the username and
+// secret stored for env.
+func (h *helperExec) Get(env string) (string, string, error) {
+	in := helperCredentials{ServerURL: env}
+	out, err := h.exec("get", in)
+	if err != nil {
+		return "", "", err
+	}
+	return out.Username, out.Secret, nil
+}
+
+// Store invokes "docker-credential-<name> store" to persist username/secret
+// for env.
+func (h *helperExec) Store(env, username, secret string) error {
+	in := helperCredentials{ServerURL: env, Username: username, Secret: secret}
+	_, err := h.exec("store", in)
+	return err
+}
+
+// Erase invokes "docker-credential-<name> erase" to remove any credentials
+// stored for env.
+func (h *helperExec) Erase(env string) error {
+	in := helperCredentials{ServerURL: env}
+	_, err := h.exec("erase", in)
+	return err
+}
+
+// exec runs the helper binary with the given subcommand, writing in as JSON
+// on stdin and, for subcommands that produce output, decoding the response
+// from stdout into a helperCredentials.
+func (h *helperExec) exec(cmd string, in helperCredentials) (helperCredentials, error) {
+	binary := helperBinaryPrefix + h.name
+
+	path, err := exec.LookPath(binary)
+	if err != nil {
+		return helperCredentials{}, fmt.Errorf("credential helper %q not found on PATH: %v", binary, err)
+	}
+
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return helperCredentials{}, err
+	}
+
+	c := exec.Command(path, cmd)
+	c.Stdin = bytes.NewReader(payload)
+
+	var stdout, stderr bytes.Buffer
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+
+	if err := c.Run(); err != nil {
+		msg := stderr.String()
+		if msg == "" {
+			msg = err.Error()
+		}
+		return helperCredentials{}, errors.New(binary + " " + cmd + ": " + msg)
+	}
+
+	out := stdout.Bytes()
+	if len(bytes.TrimSpace(out)) == 0 {
+		return helperCredentials{}, nil
+	}
+
+	var result helperCredentials
+	if err := json.Unmarshal(out, &result); err != nil {
+		return helperCredentials{}, fmt.Errorf("invalid response from %s %s: %v", binary, cmd, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,173 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// fakeHelperScript is a minimal docker-credential-<name> implementation: it
+// echoes back a fixed credential for "get", an empty JSON object for "list",
+// and accepts "store"/"erase" silently, which is enough to exercise
+// helperExec end to end.
+const fakeHelperScript = `#!/bin/sh
+case "$1" in
+  get)
+    echo '{"ServerURL":"dev","Username":"admin","Secret":"s3cr3t"}'
+    ;;
+  store|erase)
+    cat > /dev/null
+    ;;
+  list)
+    echo '{}'
+    ;;
+esac
+`
+
+// installFakeHelper writes a fake docker-credential-fake script into a
+// temporary directory and prepends it to $PATH for the duration of the test.
+func installFakeHelper(t *testing.T) {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("fake helper script is a shell script, not supported on windows")
+	}
+
+	dir := t.TempDir()
+	scriptPath := filepath.Join(dir, "docker-credential-fake")
+	if err := os.WriteFile(scriptPath, []byte(fakeHelperScript), 0755); err != nil {
+		t.Fatalf("failed to write fake helper script: %v", err)
+	}
+
+	oldPath := os.Getenv("PATH")
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+oldPath)
+	t.Cleanup(func() {
+		os.Setenv("PATH", oldPath)
+	})
+}
+
+func TestHelperExecGet(t *testing.T) {
+	installFakeHelper(t)
+
+	helper := NewHelper("fake")
+	username, secret, err := helper.Get("dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if username != "admin" || secret != "s3cr3t" {
+		t.Errorf("got (%q, %q), want (%q, %q)", username, secret, "admin", "s3cr3t")
+	}
+}
+
+func TestHelperExecStoreAndErase(t *testing.T) {
+	installFakeHelper(t)
+
+	helper := NewHelper("fake")
+	if err := helper.Store("dev", "admin", "s3cr3t"); err != nil {
+		t.Errorf("Store returned error: %v", err)
+	}
+	if err := helper.Erase("dev"); err != nil {
+		t.Errorf("Erase returned error: %v", err)
+	}
+}
+
+func TestHelperExecMissingBinary(t *testing.T) {
+	helper := NewHelper("does-not-exist")
+	if _, _, err := helper.Get("dev"); err == nil {
+		t.Error("expected error when helper binary is missing, got nil")
+	}
+}
+
+func TestFromHelperOrStoreFallsBackWhenNoHelperConfigured(t *testing.T) {
+	fileCred := Credential{Username: "file-user", Password: "file-pass"}
+
+	cred, fromHelper, err := FromHelperOrStore("", "dev", fileCred)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fromHelper {
+		t.Error("expected fromHelper to be false when no helper is configured")
+	}
+	if cred != fileCred {
+		t.Errorf("got %+v, want %+v", cred, fileCred)
+	}
+}
+
+func TestFromHelperOrStoreUsesHelper(t *testing.T) {
+	installFakeHelper(t)
+	fileCred := Credential{Username: "file-user", Password: "file-pass"}
+
+	cred, fromHelper, err := FromHelperOrStore("fake", "dev", fileCred)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fromHelper {
+		t.Error("expected fromHelper to be true when a helper is configured and has an entry")
+	}
+	if cred.Username != "admin" || cred.Password != "s3cr3t" {
+		t.Errorf("got %+v, want Username=admin Password=s3cr3t", cred)
+	}
+}
+
+func TestFromHelperOrStoreDegradesGracefullyWhenHelperMissing(t *testing.T) {
+	fileCred := Credential{Username: "file-user", Password: "file-pass"}
+
+	cred, fromHelper, err := FromHelperOrStore("does-not-exist", "dev", fileCred)
+	if err != nil {
+		t.Fatalf("expected graceful degradation, got error: %v", err)
+	}
+	if fromHelper {
+		t.Error("expected fromHelper to be false when the helper binary is missing")
+	}
+	if cred != fileCred {
+		t.Errorf("got %+v, want %+v", cred, fileCred)
+	}
+}
+
+func TestToHelperOrStoreNoOpWhenNoHelperConfigured(t *testing.T) {
+	stored, err := ToHelperOrStore("", "dev", Credential{Username: "admin", Password: "s3cr3t"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored {
+		t.Error("expected stored to be false when no helper is configured")
+	}
+}
+
+func TestToHelperOrStoreUsesHelper(t *testing.T) {
+	installFakeHelper(t)
+
+	stored, err := ToHelperOrStore("fake", "dev", Credential{Username: "admin", Password: "s3cr3t"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !stored {
+		t.Error("expected stored to be true when a helper is configured")
+	}
+}
+
+func TestToHelperOrStoreReturnsErrorWhenHelperMissing(t *testing.T) {
+	_, err := ToHelperOrStore("does-not-exist", "dev", Credential{Username: "admin", Password: "s3cr3t"})
+	if err == nil {
+		t.Error("expected error when the configured helper binary is missing")
+	}
+}
@@ -0,0 +1,60 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package utils
+
+import (
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+// MainConfig represents the structure of main_config.yaml, the CLI's
+// top-level configuration file.
+type MainConfig struct {
+	Config MainConfigConfig `yaml:"config"`
+}
+
+// MainConfigConfig holds the "config" section of main_config.yaml.
+type MainConfigConfig struct {
+	Credentials MainConfigCredentials `yaml:"credentials"`
+}
+
+// MainConfigCredentials holds the "config.credentials" section of
+// main_config.yaml.
+type MainConfigCredentials struct {
+	// Store names the docker-credential-<name> helper used to resolve
+	// environment credentials (see credentials.NewHelper). Empty means no
+	// helper is configured and the encrypted on-disk store is authoritative.
+	Store string `yaml:"store"`
+}
+
+// GetMainConfigFromFile reads and parses the main_config.yaml file located
+// at filePath.
+func GetMainConfigFromFile(filePath string) (*MainConfig, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	mainConfig := &MainConfig{}
+	if err := yaml.Unmarshal(data, mainConfig); err != nil {
+		return nil, err
+	}
+	return mainConfig, nil
+}
@@ -0,0 +1,156 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+// Package sortorder implements natural/version-aware string comparison, so
+// that strings like API versions sort as a human would expect
+// (1.2.0 < 1.10.0 < 2.0.0-beta2 < 2.0.0) rather than purely lexicographically.
+package sortorder
+
+import "unicode"
+
+// NaturalLess reports whether a should sort before b under natural order:
+// both strings are tokenized into alternating runs of digits and non-digits,
+// and corresponding runs are compared numerically when both are numeric,
+// lexicographically otherwise.
+func NaturalLess(a, b string) bool {
+	aRunes, bRunes := []rune(a), []rune(b)
+	i, j := 0, 0
+
+	for i < len(aRunes) && j < len(bRunes) {
+		aToken, aNext := nextRun(aRunes, i)
+		bToken, bNext := nextRun(bRunes, j)
+
+		if isNumericRun(aToken) && isNumericRun(bToken) {
+			if cmp := compareNumericRuns(aToken, bToken); cmp != 0 {
+				return cmp < 0
+			}
+		} else if aToken != bToken {
+			return aToken < bToken
+		}
+
+		i, j = aNext, bNext
+	}
+
+	if i >= len(aRunes) && j >= len(bRunes) {
+		return false
+	}
+
+	// One side ran out of runs while the other still has trailing runs left
+	// over (e.g. "1.2" vs "1.2.1", or "2.0.0" vs "2.0.0-beta2", both equal up
+	// through "2.0.0"). Plain separators (".", "-" with no letters, etc.) in
+	// the leftover are skipped to find the run that actually decides the
+	// comparison: a leftover that resolves to an extra numeric component
+	// sorts after ("1.2" < "1.2.1"), while one that resolves to a non-numeric
+	// label (e.g. "-beta2") sorts before ("2.0.0-beta2" < "2.0.0").
+	if j >= len(bRunes) {
+		return leftoverSortsBefore(aRunes, i)
+	}
+	return !leftoverSortsBefore(bRunes, j)
+}
+
+// leftoverSortsBefore reports whether the runs of s starting at start - the
+// portion left over once a shared prefix with another, otherwise-equal
+// string is exhausted - mark s as sorting before that other string. Runs
+// that are pure separator punctuation (non-numeric, no letters) are skipped;
+// the first run after that, which is either numeric (an extra version
+// component, sorts after) or carries a letter (a label such as a
+// pre-release suffix, sorts before), decides the result. A leftover with no
+// letter-bearing or numeric run (i.e. only trailing separators) sorts after,
+// consistent with a numeric leftover.
+func leftoverSortsBefore(s []rune, start int) bool {
+	for start < len(s) {
+		run, next := nextRun(s, start)
+		if isNumericRun(run) {
+			return false
+		}
+		if hasLetter(run) {
+			return true
+		}
+		start = next
+	}
+	return false
+}
+
+// hasLetter reports whether run contains at least one letter.
+func hasLetter(run string) bool {
+	for _, r := range run {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextRun returns the next maximal run of runes starting at start that are
+// all digits or all non-digits, along with the index immediately after it.
+func nextRun(s []rune, start int) (string, int) {
+	if start >= len(s) {
+		return "", start
+	}
+	numeric := unicode.IsDigit(s[start])
+	end := start + 1
+	for end < len(s) && unicode.IsDigit(s[end]) == numeric {
+		end++
+	}
+	return string(s[start:end]), end
+}
+
+// isNumericRun reports whether run consists entirely of digits.
+func isNumericRun(run string) bool {
+	if run == "" {
+		return false
+	}
+	for _, r := range run {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareNumericRuns compares two digit runs by numeric value, ignoring
+// leading zeroes. Runs of equal value that differ only in leading zeroes
+// (e.g. "01" vs "1") compare equal.
+func compareNumericRuns(a, b string) int {
+	a = trimLeadingZeroes(a)
+	b = trimLeadingZeroes(b)
+
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	if a == b {
+		return 0
+	}
+	if a < b {
+		return -1
+	}
+	return 1
+}
+
+// trimLeadingZeroes strips leading zeroes from a digit run, keeping at least
+// one digit.
+func trimLeadingZeroes(run string) string {
+	i := 0
+	for i < len(run)-1 && run[i] == '0' {
+		i++
+	}
+	return run[i:]
+}
@@ -0,0 +1,131 @@
+/*
+*  Copyright (c) WSO2 Inc. (http://www.wso2.org) All Rights Reserved.
+*
+*  WSO2 Inc. licenses this file to you under the Apache License,
+*  Version 2.0 (the "License"); you may not use this file except
+*  in compliance with the License.
+*  You may obtain a copy of the License at
+*
+*    http://www.apache.org/licenses/LICENSE-2.0
+*
+* Unless required by applicable law or agreed to in writing,
+* software distributed under the License is distributed on an
+* "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+* KIND, either express or implied.  See the License for the
+* specific language governing permissions and limitations
+* under the License.
+ */
+
+package sortorder
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestNaturalLess(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"1.2.0", "1.10.0", true},
+		{"1.10.0", "1.2.0", false},
+		{"1.2.0", "2.0.0-beta2", true},
+		{"2.0.0-beta2", "2.0.0", true},
+		{"2.0.0", "2.0.0-beta2", false},
+		{"1.0.0", "1.0.0", false},
+		{"a2", "a10", true},
+		{"a10", "a2", false},
+		{"a", "ab", true},
+		{"01", "1", false},
+		{"1", "01", false},
+	}
+
+	for _, tt := range tests {
+		if got := NaturalLess(tt.a, tt.b); got != tt.want {
+			t.Errorf("NaturalLess(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// TestNaturalLessPrereleaseSuffix covers versions that share a common
+// prefix where one string has a trailing non-numeric suffix (e.g. a
+// pre-release marker): the suffixed version must sort before the bare one.
+func TestNaturalLessPrereleaseSuffix(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"2.0.0-beta2", "2.0.0", true},
+		{"2.0.0", "2.0.0-beta2", false},
+		{"1.2.0-rc1", "1.2.0", true},
+		{"1.2.0", "1.2.0-rc1", false},
+	}
+
+	for _, tt := range tests {
+		if got := NaturalLess(tt.a, tt.b); got != tt.want {
+			t.Errorf("NaturalLess(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// TestNaturalLessDifferingSegmentCount covers versions that share a common
+// prefix where one string has extra dotted numeric segments: the shorter,
+// prefix version must sort before the longer one.
+func TestNaturalLessDifferingSegmentCount(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"1.2", "1.2.1", true},
+		{"1.2.1", "1.2", false},
+		{"1.2.0", "1.2.0.1", true},
+		{"1.2.0.1", "1.2.0", false},
+		{"1.2.3", "1.2.3.4", true},
+		{"1.2.3.4", "1.2.3", false},
+	}
+
+	for _, tt := range tests {
+		if got := NaturalLess(tt.a, tt.b); got != tt.want {
+			t.Errorf("NaturalLess(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNaturalLessSortsVersionList(t *testing.T) {
+	versions := []string{"2.0.0", "1.10.0", "1.2.0", "2.0.0-beta2", "1.0.0"}
+	want := []string{"1.0.0", "1.2.0", "1.10.0", "2.0.0-beta2", "2.0.0"}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return NaturalLess(versions[i], versions[j])
+	})
+
+	for i := range want {
+		if versions[i] != want[i] {
+			t.Errorf("sorted = %v, want %v", versions, want)
+			break
+		}
+	}
+}
+
+func TestNaturalLessStableOnTies(t *testing.T) {
+	type item struct {
+		version string
+		seq     int
+	}
+	items := []item{
+		{"1.0.0", 1},
+		{"1.0.0", 2},
+		{"1.0.0", 3},
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return NaturalLess(items[i].version, items[j].version)
+	})
+
+	for i, want := range []int{1, 2, 3} {
+		if items[i].seq != want {
+			t.Errorf("stable sort reordered equal keys: got seq %d at index %d, want %d", items[i].seq, i, want)
+		}
+	}
+}